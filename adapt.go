@@ -0,0 +1,179 @@
+package contextual
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	contextType        = reflect.TypeOf((*context.Context)(nil)).Elem()
+	responseWriterType = reflect.TypeOf((*http.ResponseWriter)(nil)).Elem()
+	requestType        = reflect.TypeOf(&http.Request{})
+	errorType          = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Binder decodes an incoming request into dst, typically from its body or
+// query string. It is used by Adapt for the func(ctx, *Req) (*Resp, error)
+// handler form.
+type Binder interface {
+	Bind(ctx context.Context, r *http.Request, dst interface{}) error
+}
+
+// Renderer serializes v as the response body after writing the given
+// status code. It is used by Adapt for the func(ctx, *Req) (*Resp, error)
+// handler form.
+type Renderer interface {
+	Render(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error
+}
+
+// BinderFunc allows a plain func to implement the Binder interface, the
+// same way HandlerFunc does for Handler.
+type BinderFunc func(ctx context.Context, r *http.Request, dst interface{}) error
+
+// Bind calls f, implementing the Binder interface.
+func (f BinderFunc) Bind(ctx context.Context, r *http.Request, dst interface{}) error {
+	return f(ctx, r, dst)
+}
+
+// RendererFunc allows a plain func to implement the Renderer interface,
+// the same way HandlerFunc does for Handler.
+type RendererFunc func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error
+
+// Render calls f, implementing the Renderer interface.
+func (f RendererFunc) Render(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+	return f(ctx, w, status, v)
+}
+
+// ErrorHandler turns an error returned from a handler into a response.
+type ErrorHandler func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error)
+
+// AdapterConfig supplies the pluggable pieces Adapt needs for the
+// func(ctx, *Req) (*Resp, error) handler form. Any field may be left zero;
+// a nil Binder or Renderer simply skips that step, and a nil ErrorHandler
+// falls back to writing the error text with a 500 status.
+type AdapterConfig struct {
+	Binder       Binder
+	Renderer     Renderer
+	ErrorHandler ErrorHandler
+}
+
+func (cfg *AdapterConfig) errorHandler() ErrorHandler {
+	if cfg != nil && cfg.ErrorHandler != nil {
+		return cfg.ErrorHandler
+	}
+	return defaultErrorHandler
+}
+
+func defaultErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// Adapt inspects the signature of fn and returns the equivalent Handler.
+// The reflection happens once, here, so a registration-time panic on an
+// unsupported signature replaces a per-request failure, and the Handler
+// returned pays only for a single reflect.Value.Call per request. The
+// supported shapes are:
+//
+//	func(context.Context, http.ResponseWriter, *http.Request)
+//	func(context.Context, http.ResponseWriter, *http.Request) error
+//	func(context.Context, *Req) (*Resp, error)
+//	func(http.ResponseWriter, *http.Request)
+//
+// For the third form, fn is called with a *Req populated by cfg.Binder (if
+// set), and its *Resp return value is passed to cfg.Renderer (if set); an
+// error returned from fn, or from the Binder or Renderer, is passed to
+// cfg.ErrorHandler. cfg may be nil to take the defaults.
+//
+// If fn is already a Handler or HandlerFunc, Adapt returns it unchanged.
+func Adapt(fn interface{}, cfg *AdapterConfig) Handler {
+	if h, ok := fn.(Handler); ok {
+		return h
+	}
+
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func {
+		panic(fmt.Sprintf("contextual: Adapt: %T is not a func", fn))
+	}
+
+	switch {
+	case isCtxWriterRequest(t):
+		return HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			v.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(w), reflect.ValueOf(r)})
+		})
+
+	case isCtxWriterRequestError(t):
+		eh := cfg.errorHandler()
+		return HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			out := v.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(w), reflect.ValueOf(r)})
+			if err, _ := out[0].Interface().(error); err != nil {
+				eh(ctx, w, r, err)
+			}
+		})
+
+	case isWriterRequest(t):
+		return HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			v.Call([]reflect.Value{reflect.ValueOf(w), reflect.ValueOf(r)})
+		})
+
+	case isCtxReqResp(t):
+		return adaptTyped(v, t, cfg)
+	}
+
+	panic(fmt.Sprintf("contextual: Adapt: unsupported handler signature %s", t))
+}
+
+func isCtxWriterRequest(t reflect.Type) bool {
+	return t.NumIn() == 3 && t.NumOut() == 0 &&
+		t.In(0) == contextType && t.In(1) == responseWriterType && t.In(2) == requestType
+}
+
+func isCtxWriterRequestError(t reflect.Type) bool {
+	return t.NumIn() == 3 && t.NumOut() == 1 &&
+		t.In(0) == contextType && t.In(1) == responseWriterType && t.In(2) == requestType &&
+		t.Out(0) == errorType
+}
+
+func isWriterRequest(t reflect.Type) bool {
+	return t.NumIn() == 2 && t.NumOut() == 0 &&
+		t.In(0) == responseWriterType && t.In(1) == requestType
+}
+
+func isCtxReqResp(t reflect.Type) bool {
+	return t.NumIn() == 2 && t.NumOut() == 2 &&
+		t.In(0) == contextType &&
+		t.In(1).Kind() == reflect.Ptr && t.In(1).Elem().Kind() == reflect.Struct &&
+		t.Out(0).Kind() == reflect.Ptr && t.Out(0).Elem().Kind() == reflect.Struct &&
+		t.Out(1) == errorType
+}
+
+func adaptTyped(v reflect.Value, t reflect.Type, cfg *AdapterConfig) Handler {
+	reqType := t.In(1)
+	eh := cfg.errorHandler()
+
+	return HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		reqPtr := reflect.New(reqType.Elem())
+
+		if cfg != nil && cfg.Binder != nil {
+			if err := cfg.Binder.Bind(ctx, r, reqPtr.Interface()); err != nil {
+				eh(ctx, w, r, err)
+				return
+			}
+		}
+
+		out := v.Call([]reflect.Value{reflect.ValueOf(ctx), reqPtr})
+		if err, _ := out[1].Interface().(error); err != nil {
+			eh(ctx, w, r, err)
+			return
+		}
+
+		if cfg != nil && cfg.Renderer != nil {
+			if err := cfg.Renderer.Render(ctx, w, http.StatusOK, out[0].Interface()); err != nil {
+				eh(ctx, w, r, err)
+			}
+		}
+	})
+}
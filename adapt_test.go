@@ -0,0 +1,117 @@
+package contextual
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestAdaptCtxWriterRequest(t *testing.T) {
+	h := Adapt(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "plain")
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	h.Serve(context.Background(), rr, &http.Request{})
+
+	if rr.Body.String() != "plain" {
+		t.Fatal("mismatch", rr.Body.String())
+	}
+}
+
+func TestAdaptCtxWriterRequestError(t *testing.T) {
+	h := Adapt(func(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+		return errors.New("boom")
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	h.Serve(context.Background(), rr, &http.Request{})
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatal("expected 500, got", rr.Code)
+	}
+}
+
+func TestAdaptWriterRequest(t *testing.T) {
+	h := Adapt(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "no-ctx")
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	h.Serve(context.Background(), rr, &http.Request{})
+
+	if rr.Body.String() != "no-ctx" {
+		t.Fatal("mismatch", rr.Body.String())
+	}
+}
+
+type adaptReq struct {
+	Name string
+}
+
+type adaptResp struct {
+	Greeting string
+}
+
+type funcBinder func(context.Context, *http.Request, interface{}) error
+
+func (f funcBinder) Bind(ctx context.Context, r *http.Request, dst interface{}) error {
+	return f(ctx, r, dst)
+}
+
+type funcRenderer func(context.Context, http.ResponseWriter, int, interface{}) error
+
+func (f funcRenderer) Render(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+	return f(ctx, w, status, v)
+}
+
+func TestAdaptTypedReqResp(t *testing.T) {
+	cfg := &AdapterConfig{
+		Binder: funcBinder(func(ctx context.Context, r *http.Request, dst interface{}) error {
+			dst.(*adaptReq).Name = "world"
+			return nil
+		}),
+		Renderer: funcRenderer(func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+			w.WriteHeader(status)
+			fmt.Fprintf(w, "hello, %s", v.(*adaptResp).Greeting)
+			return nil
+		}),
+	}
+
+	h := Adapt(func(ctx context.Context, req *adaptReq) (*adaptResp, error) {
+		return &adaptResp{Greeting: req.Name}, nil
+	}, cfg)
+
+	s := httptest.NewServer(NewAdapter(h, nil))
+	defer s.Close()
+
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if string(body) != "hello, world" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestAdaptPanicsOnUnsupportedSignature(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Adapt to panic")
+		}
+	}()
+
+	Adapt(func(int) string { return "" }, nil)
+}
@@ -0,0 +1,150 @@
+// Package bind provides Binder implementations that decode an incoming
+// HTTP request into a destination value, for use with contextual.Context
+// and contextual.Adapt's func(ctx, *Req) (*Resp, error) handler form.
+package bind
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/teepark/contextual"
+	"golang.org/x/net/context"
+)
+
+// Binder is an alias for contextual.Binder, so a Binder implementation in
+// this package (or any other) satisfies both without a conversion.
+type Binder = contextual.Binder
+
+type binderFunc func(context.Context, *http.Request, interface{}) error
+
+func (f binderFunc) Bind(ctx context.Context, r *http.Request, dst interface{}) error {
+	return f(ctx, r, dst)
+}
+
+// JSON decodes the request body as JSON into dst.
+var JSON Binder = binderFunc(func(ctx context.Context, r *http.Request, dst interface{}) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(dst)
+})
+
+// XML decodes the request body as XML into dst.
+var XML Binder = binderFunc(func(ctx context.Context, r *http.Request, dst interface{}) error {
+	defer r.Body.Close()
+	return xml.NewDecoder(r.Body).Decode(dst)
+})
+
+// Form decodes the request's query string and (for an
+// application/x-www-form-urlencoded body) form values into dst's exported
+// fields, matched by a `form:"name"` struct tag or by field name.
+var Form Binder = binderFunc(func(ctx context.Context, r *http.Request, dst interface{}) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	return bindValues(r.Form, dst)
+})
+
+// Multipart decodes a multipart/form-data body the same way Form decodes
+// a urlencoded one, buffering up to 32MB of non-file parts in memory (see
+// (*http.Request).ParseMultipartForm). Use NewMultipart for a different
+// memory limit.
+var Multipart = NewMultipart(32 << 20)
+
+// NewMultipart returns a Binder for multipart/form-data bodies, buffering
+// up to maxMemory bytes of non-file parts in memory.
+func NewMultipart(maxMemory int64) Binder {
+	return binderFunc(func(ctx context.Context, r *http.Request, dst interface{}) error {
+		if err := r.ParseMultipartForm(maxMemory); err != nil {
+			return err
+		}
+		return bindValues(r.Form, dst)
+	})
+}
+
+// ByContentType returns a Binder that dispatches to JSON, XML, Form, or
+// Multipart based on the request's Content-Type header, defaulting to
+// JSON when the header is absent or unrecognized.
+func ByContentType() Binder {
+	return binderFunc(func(ctx context.Context, r *http.Request, dst interface{}) error {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			mediaType = "application/json"
+		}
+
+		switch mediaType {
+		case "application/xml", "text/xml":
+			return XML.Bind(ctx, r, dst)
+		case "multipart/form-data":
+			return Multipart.Bind(ctx, r, dst)
+		case "application/x-www-form-urlencoded":
+			return Form.Bind(ctx, r, dst)
+		default:
+			return JSON.Bind(ctx, r, dst)
+		}
+	})
+}
+
+func bindValues(values url.Values, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("bind: dst must be a pointer to a struct, got %T", dst)
+	}
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Tag.Get("form")
+		if name == "" {
+			name = field.Name
+		}
+
+		raw := values.Get(name)
+		if raw == "" {
+			continue
+		}
+
+		if err := setField(v.Field(i), raw); err != nil {
+			return fmt.Errorf("bind: field %s: %v", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func setField(f reflect.Value, raw string) error {
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field kind %s", f.Kind())
+	}
+	return nil
+}
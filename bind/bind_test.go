@@ -0,0 +1,81 @@
+package bind
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+type person struct {
+	Name string
+	Age  int `form:"years"`
+}
+
+func TestJSONBind(t *testing.T) {
+	r, err := http.NewRequest("POST", "/", strings.NewReader(`{"Name":"ada","Age":36}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p person
+	if err := JSON.Bind(context.Background(), r, &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "ada" || p.Age != 36 {
+		t.Fatalf("mismatch: %+v", p)
+	}
+}
+
+func TestFormBind(t *testing.T) {
+	r, err := http.NewRequest("GET", "/?Name=ada&years=36", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p person
+	if err := Form.Bind(context.Background(), r, &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "ada" || p.Age != 36 {
+		t.Fatalf("mismatch: %+v", p)
+	}
+}
+
+func TestByContentTypeDefaultsToJSON(t *testing.T) {
+	r, err := http.NewRequest("POST", "/", strings.NewReader(`{"Name":"ada"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p person
+	if err := ByContentType().Bind(context.Background(), r, &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "ada" {
+		t.Fatalf("mismatch: %+v", p)
+	}
+}
+
+func TestByContentTypeDispatchesForm(t *testing.T) {
+	form := url.Values{"Name": {"ada"}}
+	r, err := http.NewRequest("POST", "/", strings.NewReader(form.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var p person
+	if err := ByContentType().Bind(context.Background(), r, &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Name != "ada" {
+		t.Fatalf("mismatch: %+v", p)
+	}
+}
@@ -0,0 +1,67 @@
+package contextual
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+// Context bundles together a request's context.Context, ResponseWriter,
+// and Request with the Binder and Renderer configured for it (see
+// router.New), so a handler can decode and respond without re-threading
+// all four values itself.
+type Context struct {
+	context.Context
+	W http.ResponseWriter
+	R *http.Request
+
+	binder   Binder
+	renderer Renderer
+}
+
+type configKey int
+
+const bindRenderKey configKey = 0
+
+type bindRenderConfig struct {
+	binder   Binder
+	renderer Renderer
+}
+
+// WithConfig returns a copy of ctx carrying the given Binder and Renderer for
+// later retrieval by From. router.New installs this for every request
+// when given a non-nil Binder or Renderer.
+func WithConfig(ctx context.Context, b Binder, r Renderer) context.Context {
+	return context.WithValue(ctx, bindRenderKey, bindRenderConfig{b, r})
+}
+
+// From builds a Context for a single request, picking up the Binder and
+// Renderer installed with WithConfig, if any.
+func From(ctx context.Context, w http.ResponseWriter, r *http.Request) *Context {
+	cfg, _ := ctx.Value(bindRenderKey).(bindRenderConfig)
+	return &Context{Context: ctx, W: w, R: r, binder: cfg.binder, renderer: cfg.renderer}
+}
+
+var errNoBinder = errors.New("contextual: no Binder configured")
+
+// Bind decodes the request into dst using the configured Binder.
+func (c *Context) Bind(dst interface{}) error {
+	if c.binder == nil {
+		return errNoBinder
+	}
+	return c.binder.Bind(c.Context, c.R, dst)
+}
+
+// JSON writes status, then renders v as the response body through the
+// configured Renderer, or by encoding it directly as JSON if none was
+// configured.
+func (c *Context) JSON(status int, v interface{}) error {
+	if c.renderer != nil {
+		return c.renderer.Render(c.Context, c.W, status, v)
+	}
+	c.W.Header().Set("Content-Type", "application/json; charset=utf-8")
+	c.W.WriteHeader(status)
+	return json.NewEncoder(c.W).Encode(v)
+}
@@ -0,0 +1,46 @@
+package contextual
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestContextBindRequiresConfiguredBinder(t *testing.T) {
+	c := From(context.Background(), httptest.NewRecorder(), nil)
+	if err := c.Bind(&struct{}{}); err == nil {
+		t.Fatal("expected error with no Binder configured")
+	}
+}
+
+func TestContextBindUsesConfiguredBinder(t *testing.T) {
+	var dst struct{ Name string }
+
+	ctx := WithConfig(context.Background(), BinderFunc(func(_ context.Context, _ *http.Request, d interface{}) error {
+		d.(*struct{ Name string }).Name = "ada"
+		return nil
+	}), nil)
+
+	c := From(ctx, httptest.NewRecorder(), nil)
+	if err := c.Bind(&dst); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Name != "ada" {
+		t.Fatal("mismatch", dst.Name)
+	}
+}
+
+func TestContextJSONDefaultsToEncodingJSON(t *testing.T) {
+	rr := httptest.NewRecorder()
+	c := From(context.Background(), rr, nil)
+
+	if err := c.JSON(200, map[string]string{"hi": "there"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if rr.Body.String() != "{\"hi\":\"there\"}\n" {
+		t.Fatal("unexpected body", rr.Body.String())
+	}
+}
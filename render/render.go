@@ -0,0 +1,128 @@
+// Package render provides Renderer implementations that serialize a value
+// as an HTTP response body, for use with contextual.Context and
+// contextual.Adapt's func(ctx, *Req) (*Resp, error) handler form.
+package render
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/teepark/contextual"
+	"golang.org/x/net/context"
+)
+
+// Renderer is an alias for contextual.Renderer, so a Renderer
+// implementation in this package (or any other) satisfies both without a
+// conversion.
+type Renderer = contextual.Renderer
+
+type rendererFunc func(context.Context, http.ResponseWriter, int, interface{}) error
+
+func (f rendererFunc) Render(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+	return f(ctx, w, status, v)
+}
+
+// JSON renders v as JSON via encoding/json.
+var JSON Renderer = rendererFunc(func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+})
+
+// XML renders v as XML via encoding/xml.
+var XML Renderer = rendererFunc(func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	return xml.NewEncoder(w).Encode(v)
+})
+
+// HTML returns a Renderer that executes v against tmpl, using
+// tmpl.ExecuteTemplate with the given name if it's non-empty, or
+// tmpl.Execute otherwise.
+func HTML(tmpl *template.Template, name string) Renderer {
+	return rendererFunc(func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(status)
+		if name == "" {
+			return tmpl.Execute(w, v)
+		}
+		return tmpl.ExecuteTemplate(w, name, v)
+	})
+}
+
+type acceptKey int
+
+const requestAcceptKey acceptKey = 0
+
+// WithAccept returns a Context carrying the given Accept header, for a
+// Renderer built with ByAccept to negotiate against.
+func WithAccept(ctx context.Context, accept string) context.Context {
+	return context.WithValue(ctx, requestAcceptKey, accept)
+}
+
+// ByAccept returns a Renderer that negotiates against the Accept header
+// installed with WithAccept, preferring candidates (keyed by MIME type) in
+// descending order of the header's q-values, and falling back to fallback
+// (or JSON, if fallback is nil) when nothing in the header matches a
+// candidate.
+func ByAccept(candidates map[string]Renderer, fallback Renderer) Renderer {
+	if fallback == nil {
+		fallback = JSON
+	}
+
+	return rendererFunc(func(ctx context.Context, w http.ResponseWriter, status int, v interface{}) error {
+		accept, _ := ctx.Value(requestAcceptKey).(string)
+		for _, mt := range rankAccept(accept) {
+			if r, ok := candidates[mt]; ok {
+				return r.Render(ctx, w, status, v)
+			}
+		}
+		return fallback.Render(ctx, w, status, v)
+	})
+}
+
+// rankAccept parses an Accept header into its media types, ordered from
+// most to least preferred by q-value.
+func rankAccept(header string) []string {
+	type entry struct {
+		mediaType string
+		q         float64
+	}
+
+	var entries []entry
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		pieces := strings.Split(part, ";")
+		mt := strings.TrimSpace(pieces[0])
+		q := 1.0
+		for _, p := range pieces[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				if parsed, err := strconv.ParseFloat(p[2:], 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+
+		entries = append(entries, entry{mt, q})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].q > entries[j].q
+	})
+
+	mts := make([]string, len(entries))
+	for i, e := range entries {
+		mts[i] = e.mediaType
+	}
+	return mts
+}
@@ -0,0 +1,59 @@
+package render
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+type greeting struct {
+	Text string `json:"text" xml:"text"`
+}
+
+func TestJSONRender(t *testing.T) {
+	rr := httptest.NewRecorder()
+	if err := JSON.Render(context.Background(), rr, 200, greeting{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ct := rr.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Fatal("unexpected content type", ct)
+	}
+	if rr.Body.String() != "{\"text\":\"hi\"}\n" {
+		t.Fatal("unexpected body", rr.Body.String())
+	}
+}
+
+func TestByAcceptNegotiates(t *testing.T) {
+	renderer := ByAccept(map[string]Renderer{
+		"application/json": JSON,
+		"application/xml":  XML,
+	}, nil)
+
+	ctx := WithAccept(context.Background(), "application/xml;q=0.9, application/json;q=0.1")
+	rr := httptest.NewRecorder()
+	if err := renderer.Render(ctx, rr, 200, greeting{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ct := rr.Header().Get("Content-Type"); ct != "application/xml; charset=utf-8" {
+		t.Fatal("unexpected content type", ct)
+	}
+}
+
+func TestByAcceptFallsBack(t *testing.T) {
+	renderer := ByAccept(map[string]Renderer{
+		"application/xml": XML,
+	}, nil)
+
+	ctx := WithAccept(context.Background(), "text/plain")
+	rr := httptest.NewRecorder()
+	if err := renderer.Render(ctx, rr, 200, greeting{"hi"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if ct := rr.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Fatal("unexpected content type", ct)
+	}
+}
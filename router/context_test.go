@@ -0,0 +1,82 @@
+package router
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/teepark/contextual"
+	"github.com/teepark/contextual/bind"
+	"github.com/teepark/contextual/render"
+	"golang.org/x/net/context"
+)
+
+type greetReq struct {
+	Name string
+}
+
+func TestRouterWiresBinderAndRenderer(t *testing.T) {
+	r := New(nil, nil, bind.JSON, render.JSON, nil)
+	r.POST("/", contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		c := contextual.From(ctx, w, req)
+
+		var greet greetReq
+		if err := c.Bind(&greet); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := c.JSON(200, map[string]string{"greeting": "hi, " + greet.Name}); err != nil {
+			t.Fatal(err)
+		}
+	}))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Post(s.URL, "application/json", strings.NewReader(`{"Name":"ada"}`))
+	if err != nil {
+		t.Fatal("POST", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if string(body) != "{\"greeting\":\"hi, ada\"}\n" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestRouterNegotiatesAcceptHeader(t *testing.T) {
+	renderer := render.ByAccept(map[string]render.Renderer{
+		"application/xml": render.XML,
+	}, render.JSON)
+
+	r := New(nil, nil, nil, renderer, nil)
+	r.GET("/", contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		contextual.From(ctx, w, req).JSON(200, greetReq{Name: "ada"})
+	}))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	req, err := http.NewRequest("GET", s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "application/xml")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+	resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/xml") {
+		t.Fatalf("expected negotiated application/xml, got %q", ct)
+	}
+}
@@ -0,0 +1,47 @@
+package router
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFallbackDelegatesUnmatchedRequests(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/legacy", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("legacy handler"))
+	})
+
+	r := New(nil, nil, nil, nil, mux)
+	r.GET("/new", tagApp("new handler"))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/new")
+	if err != nil {
+		t.Fatal("GET /new", err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+	if string(body) != "new handler" {
+		t.Fatal("mismatch", string(body))
+	}
+
+	resp, err = http.Get(s.URL + "/legacy")
+	if err != nil {
+		t.Fatal("GET /legacy", err)
+	}
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+	if string(body) != "legacy handler" {
+		t.Fatal("mismatch", string(body))
+	}
+}
@@ -0,0 +1,89 @@
+package router
+
+import (
+	"github.com/teepark/contextual/middleware"
+)
+
+// Group represents a set of routes sharing a URL prefix and a chain of
+// middleware inherited by every route (and subgroup) registered under it.
+// Groups implement the same verb helpers as Router, so registering a route
+// on a Group reads just like registering one directly on the Router.
+type Group struct {
+	router *Router
+	prefix string
+	mw     middleware.Chain
+}
+
+// Group creates a new Group rooted at the path prefix, wrapped (in addition
+// to the Router's own middleware) by the given middleware.
+func (router *Router) Group(prefix string, mw ...middleware.Middleware) *Group {
+	return &Group{
+		router: router,
+		prefix: prefix,
+		mw:     middleware.Chain(mw),
+	}
+}
+
+// Group creates a subgroup, extending this Group's prefix and appending
+// further middleware onto the inherited chain. The parent's middleware
+// still runs first (outermost), with the subgroup's own middleware wrapped
+// around its handlers inside that.
+func (g *Group) Group(prefix string, mw ...middleware.Middleware) *Group {
+	chain := make(middleware.Chain, 0, len(g.mw)+len(mw))
+	chain = append(chain, g.mw...)
+	chain = append(chain, mw...)
+
+	return &Group{
+		router: g.router,
+		prefix: g.prefix + prefix,
+		mw:     chain,
+	}
+}
+
+// Handle registers a method/path handler under the Group's prefix, wrapped
+// by the Group's middleware chain. fn may be a contextual.Handler or any of
+// the func shapes accepted by contextual.Adapt. opts are passed through to
+// the underlying Router.Handle (e.g. Name to register the route for
+// Router.URL). Routes() reports the name of fn itself, not of the
+// middleware-wrapped handler actually dispatched to, since the wrapper
+// wouldn't mean anything to a caller inspecting the route table.
+func (g *Group) Handle(method, path string, fn interface{}, opts ...Option) {
+	handle := adaptHandler(fn, g.router.binder, g.router.renderer)
+	opts = append(opts, withDisplayFunc(fn))
+	g.router.Handle(method, g.prefix+path, g.mw.Then(handle), opts...)
+}
+
+// GET is a shortcut for Handle("GET", ...)
+func (g *Group) GET(path string, handle interface{}, opts ...Option) {
+	g.Handle("GET", path, handle, opts...)
+}
+
+// HEAD is a shortcut for Handle("HEAD", ...)
+func (g *Group) HEAD(path string, handle interface{}, opts ...Option) {
+	g.Handle("HEAD", path, handle, opts...)
+}
+
+// POST is a shortcut for Handle("POST", ...)
+func (g *Group) POST(path string, handle interface{}, opts ...Option) {
+	g.Handle("POST", path, handle, opts...)
+}
+
+// PUT is a shortcut for Handle("PUT", ...)
+func (g *Group) PUT(path string, handle interface{}, opts ...Option) {
+	g.Handle("PUT", path, handle, opts...)
+}
+
+// DELETE is a shortcut for Handle("DELETE", ...)
+func (g *Group) DELETE(path string, handle interface{}, opts ...Option) {
+	g.Handle("DELETE", path, handle, opts...)
+}
+
+// OPTIONS is a shortcut for Handle("OPTIONS", ...)
+func (g *Group) OPTIONS(path string, handle interface{}, opts ...Option) {
+	g.Handle("OPTIONS", path, handle, opts...)
+}
+
+// PATCH is a shortcut for Handle("PATCH", ...)
+func (g *Group) PATCH(path string, handle interface{}, opts ...Option) {
+	g.Handle("PATCH", path, handle, opts...)
+}
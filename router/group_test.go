@@ -0,0 +1,102 @@
+package router
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/teepark/contextual"
+	"github.com/teepark/contextual/middleware"
+	"golang.org/x/net/context"
+)
+
+func tagMiddleware(tag string) middleware.Middleware {
+	return middleware.Middleware(func(h contextual.Handler) contextual.Handler {
+		return contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, tag)
+			h.Serve(ctx, w, r)
+		})
+	})
+}
+
+func tagApp(tag string) contextual.Handler {
+	return contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, tag)
+	})
+}
+
+func TestGroupPrefixesPaths(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	g := r.Group("/api")
+	g.GET("/users", tagApp("users"))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/api/users")
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+	if string(body) != "users" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestGroupVerbShortcutAdaptsPlainFunc(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	g := r.Group("/api", tagMiddleware("mw\n"))
+	g.GET("/plain", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, "plain")
+	})
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/api/plain")
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+	if string(body) != "mw\nplain" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestGroupMiddlewareOrder(t *testing.T) {
+	r := New(nil, middleware.Chain{tagMiddleware("router\n")}, nil, nil, nil)
+	g := r.Group("/api", tagMiddleware("group\n"))
+	admin := g.Group("/admin", tagMiddleware("admin\n"))
+	admin.POST("/purge", tagApp("purge"))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Post(s.URL+"/api/admin/purge", "text/plain", nil)
+	if err != nil {
+		t.Fatal("POST", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	expected := "router\ngroup\nadmin\npurge"
+	if string(body) != expected {
+		t.Fatalf("expected %q, got %q", expected, string(body))
+	}
+}
@@ -0,0 +1,134 @@
+package router
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/teepark/contextual"
+	"golang.org/x/net/context"
+)
+
+func TestSetNotFound(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.SetNotFound(contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(404)
+		w.Write([]byte("nowhere to be found"))
+	}))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/nope")
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if resp.StatusCode != 404 {
+		t.Fatal("expected 404, got", resp.StatusCode)
+	}
+	if string(body) != "nowhere to be found" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestSetMethodNotAllowed(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/", contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {}))
+	r.SetMethodNotAllowed(contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(405)
+		w.Write([]byte("nope"))
+	}))
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Post(s.URL, "text/plain", nil)
+	if err != nil {
+		t.Fatal("POST", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if resp.StatusCode != 405 {
+		t.Fatal("expected 405, got", resp.StatusCode)
+	}
+	if string(body) != "nope" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestSetPanicHandler(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/", contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		panic("boom")
+	}))
+	r.SetPanicHandler(func(ctx context.Context, w http.ResponseWriter, req *http.Request, recovered interface{}) {
+		w.WriteHeader(500)
+		w.Write([]byte(ctx.Value(PanicKey).(string)))
+	})
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if resp.StatusCode != 500 {
+		t.Fatal("expected 500, got", resp.StatusCode)
+	}
+	if string(body) != "boom" {
+		t.Fatal("mismatch", string(body))
+	}
+}
+
+func TestSetPanicHandlerCatchesNotFoundPanic(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.SetNotFound(contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, req *http.Request) {
+		panic("no route here")
+	}))
+	r.SetPanicHandler(func(ctx context.Context, w http.ResponseWriter, req *http.Request, recovered interface{}) {
+		w.WriteHeader(500)
+		w.Write([]byte(ctx.Value(PanicKey).(string)))
+	})
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/nope")
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read", err)
+	}
+
+	if resp.StatusCode != 500 {
+		t.Fatal("expected 500, got", resp.StatusCode)
+	}
+	if string(body) != "no route here" {
+		t.Fatal("mismatch", string(body))
+	}
+}
@@ -27,7 +27,7 @@ A simple example looks like this:
 	}
 
 	func main() {
-		router := router.New(nil, nil) // take defaults
+		router := router.New(nil, nil, nil, nil, nil) // take defaults
 		router.GET("/", contextual.HandlerFunc(index))
 		router.GET("/hello/:name", contextual.HandlerFunc(hello))
 
@@ -43,6 +43,7 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/teepark/contextual"
 	"github.com/teepark/contextual/middleware"
+	"github.com/teepark/contextual/render"
 	"golang.org/x/net/context"
 )
 
@@ -50,28 +51,54 @@ import (
 // will be stored.
 const ParamKey = "params"
 
+// PanicKey is the key in a context.Context under which the value recovered
+// from a panicking handler is stored before the configured panic handler
+// (see SetPanicHandler) runs. It is only visible inside that handler: the
+// recover happens in handlerShim, outside the whole middleware chain, so a
+// panic already unwound past every middleware's own code by the time
+// PanicKey is set.
+const PanicKey = "panic"
+
 // Router wraps an httprouter.Router to accept contextual.Handlers. For
 // each request it adds the httprouter.Params to the context as
 // Value(router.ParamKey), then passes it to the appropriate (optionally
 // middleware-wrapped) handler.
 type Router struct {
-	router *httprouter.Router
-	mw     middleware.Middleware
+	router       *httprouter.Router
+	mw           middleware.Chain
+	binder       contextual.Binder
+	renderer     contextual.Renderer
+	panicHandler func(ctx context.Context, w http.ResponseWriter, r *http.Request, recovered interface{})
+	routes       []RouteInfo
+	named        map[string]string
 }
 
 // New creates a new Router around a given httprouter.Router.
 // All arguments may be nil, in which case the Router would wrap a
-// Router created with httprouter.New() and there would be no
-// middleware applied.
-func New(router *httprouter.Router, mw middleware.Middleware) *Router {
+// Router created with httprouter.New(), there would be no middleware
+// applied, contextual.Context.Bind/JSON would fall back to their own
+// defaults, and unmatched requests would get httprouter's ordinary 404.
+// The binder and renderer are used both for requests that go through
+// contextual.Context (see contextual.From) and for handlers registered
+// with the func(ctx, *Req) (*Resp, error) shape that Handle adapts via
+// contextual.Adapt. fallback, if given, is installed with Fallback.
+func New(router *httprouter.Router, mw middleware.Chain, binder contextual.Binder, renderer contextual.Renderer, fallback http.Handler) *Router {
 	if router == nil {
 		router = httprouter.New()
 	}
 
-	return &Router{
-		router: router,
-		mw: mw,
+	rt := &Router{
+		router:   router,
+		mw:       mw,
+		binder:   binder,
+		renderer: renderer,
+	}
+
+	if fallback != nil {
+		rt.Fallback(fallback)
 	}
+
+	return rt
 }
 
 // ServeHTTP implements http.Handler
@@ -85,11 +112,32 @@ func (router *Router) ServeFiles(path string, root http.FileSystem) {
 	router.router.ServeFiles(path, root)
 }
 
-// Handle adds a method/path handler with a context.Context argument
-func (router *Router) Handle(method, path string, handle contextual.Handler) {
+// Handle adds a method/path handler with a context.Context argument. fn may
+// be a contextual.Handler, or any of the func shapes accepted by
+// contextual.Adapt, in which case it is adapted once here at registration
+// time. opts may include Name to register the route for later lookup with
+// Router.URL.
+func (router *Router) Handle(method, path string, fn interface{}, opts ...Option) {
+	handle := adaptHandler(fn, router.binder, router.renderer)
+
+	router.recordRoute(method, path, fn, opts)
+
 	router.router.Handle(method, path, handlerShim(router, handle))
 }
 
+// adaptHandler returns fn unchanged if it's already a contextual.Handler,
+// otherwise adapts it via contextual.Adapt using binder/renderer for the
+// func(ctx, *Req) (*Resp, error) shape.
+func adaptHandler(fn interface{}, binder contextual.Binder, renderer contextual.Renderer) contextual.Handler {
+	if h, ok := fn.(contextual.Handler); ok {
+		return h
+	}
+	return contextual.Adapt(fn, &contextual.AdapterConfig{
+		Binder:   binder,
+		Renderer: renderer,
+	})
+}
+
 // Handle adds an http.Handler for a method/path
 func (router *Router) Handler(method, path string, handler http.Handler) {
 	router.router.Handler(method, path, handler)
@@ -100,60 +148,145 @@ func (router *Router) HandlerFunc(method, path string, handler http.HandlerFunc)
 	router.router.HandlerFunc(method, path, handler)
 }
 
-// GET is a shortcut for Handle("GET", ...)
-func (router *Router) GET(path string, handle contextual.Handler) {
-	router.Handle("GET", path, handle)
+// GET is a shortcut for Handle("GET", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) GET(path string, handle interface{}, opts ...Option) {
+	router.Handle("GET", path, handle, opts...)
+}
+
+// HEAD is a shortcut for Handle("HEAD", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) HEAD(path string, handle interface{}, opts ...Option) {
+	router.Handle("HEAD", path, handle, opts...)
+}
+
+// POST is a shortcut for Handle("POST", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) POST(path string, handle interface{}, opts ...Option) {
+	router.Handle("POST", path, handle, opts...)
+}
+
+// PUT is a shortcut for Handle("PUT", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) PUT(path string, handle interface{}, opts ...Option) {
+	router.Handle("PUT", path, handle, opts...)
 }
 
-// HEAD is a shortcut for Handle("HEAD", ...)
-func (router *Router) HEAD(path string, handle contextual.Handler) {
-	router.Handle("HEAD", path, handle)
+// DELETE is a shortcut for Handle("DELETE", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) DELETE(path string, handle interface{}, opts ...Option) {
+	router.Handle("DELETE", path, handle, opts...)
 }
 
-// POST is a shortcut for Handle("POST", ...)
-func (router *Router) POST(path string, handle contextual.Handler) {
-	router.Handle("POST", path, handle)
+// OPTIONS is a shortcut for Handle("OPTIONS", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) OPTIONS(path string, handle interface{}, opts ...Option) {
+	router.Handle("OPTIONS", path, handle, opts...)
 }
 
-// PUT is a shortcut for Handle("PUT", ...)
-func (router *Router) PUT(path string, handle contextual.Handler) {
-	router.Handle("PUT", path, handle)
+// PATCH is a shortcut for Handle("PATCH", ...). handle may be a
+// contextual.Handler or any of the func shapes accepted by contextual.Adapt.
+func (router *Router) PATCH(path string, handle interface{}, opts ...Option) {
+	router.Handle("PATCH", path, handle, opts...)
 }
 
-// DELETE is a shortcut for Handle("DELETE", ...)
-func (router *Router) DELETE(path string, handle contextual.Handler) {
-	router.Handle("DELETE", path, handle)
+// SetNotFound installs h as httprouter's NotFound handler, running it
+// through the Router's middleware chain just like a normal endpoint.
+// Since no route matched, there are no httprouter.Params to add to the
+// context.
+func (router *Router) SetNotFound(h contextual.Handler) {
+	router.router.NotFound = noParamsShim(router, h)
 }
 
-// OPTIONS is a shortcut for Handle("OPTIONS", ...)
-func (router *Router) OPTIONS(path string, handle contextual.Handler) {
-	router.Handle("OPTIONS", path, handle)
+// SetMethodNotAllowed installs h as httprouter's MethodNotAllowed handler,
+// running it through the Router's middleware chain just like a normal
+// endpoint.
+func (router *Router) SetMethodNotAllowed(h contextual.Handler) {
+	router.router.MethodNotAllowed = noParamsShim(router, h)
 }
 
-// PATCH is a shortcut for Handle("PATCH", ...)
-func (router *Router) PATCH(path string, handle contextual.Handler) {
-	router.Handle("PATCH", path, handle)
+// Fallback installs h as a fallback http.Handler for requests whose path
+// matches no route registered on the Router, running the Router's
+// middleware chain first and then delegating to h. This lets an existing
+// mux (net/http.ServeMux, chi, gorilla/mux, ...) keep serving whatever
+// hasn't been moved over yet, so an application can adopt Router one
+// route at a time instead of all at once. Internally this replaces
+// httprouter's NotFound, so it cannot be combined with SetNotFound; the
+// later call wins. A request for a path registered only under other
+// methods still goes to httprouter's MethodNotAllowed handling, not to h.
+func (router *Router) Fallback(h http.Handler) {
+	router.SetNotFound(contextual.HandlerFunc(func(_ context.Context, w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r)
+	}))
+}
+
+// SetPanicHandler installs f to run when a registered handler panics
+// during Serve, in place of the net/http server's default of logging the
+// panic and closing the connection. The recovered value is stashed on
+// the context as Value(PanicKey) before f runs, so f can log it
+// alongside the rest of the request's context.
+//
+// The recover happens in handlerShim, wrapping the Router's whole
+// middleware chain, not inside it: a panic unwinds straight past every
+// middleware's own code (none of it defers anything) to that recover, so
+// f is the only place PanicKey is visible, and middleware further out in
+// the chain does not get a chance to run its own logic for a panicking
+// request. A middleware wanting to observe a panic needs its own
+// defer/recover around its call to the inner Handler.
+func (router *Router) SetPanicHandler(f func(ctx context.Context, w http.ResponseWriter, r *http.Request, recovered interface{})) {
+	router.panicHandler = f
 }
 
 func handlerShim(router *Router, ctxHandle contextual.Handler) httprouter.Handle {
 	return httprouter.Handle(func(w http.ResponseWriter, r *http.Request, p httprouter.Params) {
 		ctx := context.WithValue(context.Background(), ParamKey, p)
+		ctx = router.requestContext(ctx, r)
 
-		var handle contextual.Handler
-		if router.mw != nil {
-			handle = contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-				ctx = router.mw.Inbound(ctx, w, r)
-				ctxHandle.Serve(ctx, w, r)
-				router.mw.Outbound(ctx, r)
-			})
-		} else {
-			handle = ctxHandle
+		handle := router.mw.Then(ctxHandle)
+
+		if router.panicHandler != nil {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					router.panicHandler(context.WithValue(ctx, PanicKey, recovered), w, r, recovered)
+				}
+			}()
 		}
 
 		handle.Serve(ctx, w, r)
 	})
 }
 
+func noParamsShim(router *Router, ctxHandle contextual.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := router.requestContext(context.Background(), r)
+
+		handle := router.mw.Then(ctxHandle)
+
+		if router.panicHandler != nil {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					router.panicHandler(context.WithValue(ctx, PanicKey, recovered), w, r, recovered)
+				}
+			}()
+		}
+
+		handle.Serve(ctx, w, r)
+	})
+}
+
+// requestContext adds the Binder/Renderer config (if any) and, when a
+// Renderer is configured, the request's Accept header (for render.ByAccept)
+// to ctx.
+func (router *Router) requestContext(ctx context.Context, r *http.Request) context.Context {
+	if router.binder != nil || router.renderer != nil {
+		ctx = contextual.WithConfig(ctx, router.binder, router.renderer)
+	}
+	if router.renderer != nil {
+		ctx = render.WithAccept(ctx, r.Header.Get("Accept"))
+	}
+	return ctx
+}
+
 // Params retrieves the httprouter Params from a context
 func Params(c context.Context) httprouter.Params {
 	p := c.Value(ParamKey)
@@ -13,7 +13,7 @@ import (
 )
 
 func TestRouterRoutes(t *testing.T) {
-	r := New(nil, nil)
+	r := New(nil, nil, nil, nil, nil)
 	r.Handle("GET", "/", contextual.HandlerFunc(func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 		io.WriteString(w, "I'm a GET")
 	}))
@@ -77,22 +77,22 @@ func TestRouterRoutes(t *testing.T) {
 
 type routerMethodSpec struct {
 	name string
-	f    func(*Router) func(string, contextual.Handler)
+	f    func(*Router) func(string, interface{}, ...Option)
 }
 
 var routerMethodSpecs = []routerMethodSpec{
-	{"GET", func(r *Router) func(string, contextual.Handler) { return r.GET }},
-	{"HEAD", func(r *Router) func(string, contextual.Handler) { return r.HEAD }},
-	{"POST", func(r *Router) func(string, contextual.Handler) { return r.POST }},
-	{"PUT", func(r *Router) func(string, contextual.Handler) { return r.PUT }},
-	{"DELETE", func(r *Router) func(string, contextual.Handler) { return r.DELETE }},
-	{"OPTIONS", func(r *Router) func(string, contextual.Handler) { return r.OPTIONS }},
-	{"PATCH", func(r *Router) func(string, contextual.Handler) { return r.PATCH }},
+	{"GET", func(r *Router) func(string, interface{}, ...Option) { return r.GET }},
+	{"HEAD", func(r *Router) func(string, interface{}, ...Option) { return r.HEAD }},
+	{"POST", func(r *Router) func(string, interface{}, ...Option) { return r.POST }},
+	{"PUT", func(r *Router) func(string, interface{}, ...Option) { return r.PUT }},
+	{"DELETE", func(r *Router) func(string, interface{}, ...Option) { return r.DELETE }},
+	{"OPTIONS", func(r *Router) func(string, interface{}, ...Option) { return r.OPTIONS }},
+	{"PATCH", func(r *Router) func(string, interface{}, ...Option) { return r.PATCH }},
 }
 
 func TestRouterMethodFuncs(t *testing.T) {
 	// TODO: useful comments in this function, lots of high-level indirection to explain
-	r := New(nil, nil)
+	r := New(nil, nil, nil, nil, nil)
 
 	handlerForMethod := func(method string) contextual.HandlerFunc {
 		return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
@@ -153,7 +153,7 @@ func TestInboundMiddlewareRuns(t *testing.T) {
 		})
 	})
 
-	router := New(nil, initer)
+	router := New(nil, middleware.Chain{initer}, nil, nil, nil)
 
 	router.GET("/", contextual.HandlerFunc(func(c context.Context, w http.ResponseWriter, r *http.Request) {
 		ival := c.Value("key")
@@ -185,3 +185,28 @@ func TestInboundMiddlewareRuns(t *testing.T) {
 		t.Fatal("mismatch:", string(msg))
 	}
 }
+
+func TestVerbShortcutAdaptsPlainFunc(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/", func(w http.ResponseWriter, req *http.Request) {
+		io.WriteString(w, "plain func")
+	})
+
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	resp, err := http.Get(s.URL)
+	if err != nil {
+		t.Fatal("GET", err)
+	}
+
+	msg, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal("read:", err)
+	}
+
+	if string(msg) != "plain func" {
+		t.Fatal("mismatch:", string(msg))
+	}
+}
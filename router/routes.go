@@ -0,0 +1,118 @@
+package router
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// RouteInfo describes a single route registered on a Router.
+type RouteInfo struct {
+	Method  string
+	Path    string
+	Handler string
+}
+
+// Option customizes a single call to Handle (or one of its verb
+// shortcuts).
+type Option func(*registration)
+
+type registration struct {
+	name      string
+	displayFn interface{}
+}
+
+// Name registers the route under name, so a path for it can later be
+// generated with Router.URL(name, params...).
+func Name(name string) Option {
+	return func(reg *registration) {
+		reg.name = name
+	}
+}
+
+// withDisplayFunc overrides the function Routes() derives a route's
+// Handler name from. It exists for callers like Group that wrap a
+// handler (e.g. with middleware) before passing it to Router.Handle, so
+// Routes() still reports the name of the handler the caller registered
+// rather than that of the wrapper.
+func withDisplayFunc(fn interface{}) Option {
+	return func(reg *registration) {
+		reg.displayFn = fn
+	}
+}
+
+// Routes returns the method, path, and handler name for every route
+// registered through Handle (directly, via a verb shortcut, or via a
+// Group), in registration order. Routes added with Handler, HandlerFunc,
+// or ServeFiles are not listed.
+func (router *Router) Routes() []RouteInfo {
+	routes := make([]RouteInfo, len(router.routes))
+	copy(routes, router.routes)
+	return routes
+}
+
+// URL builds a path for the route registered under name (see Name),
+// substituting params, in order, for each :param or *param segment of its
+// path template.
+func (router *Router) URL(name string, params ...string) (string, error) {
+	path, ok := router.named[name]
+	if !ok {
+		return "", fmt.Errorf("router: no route named %q", name)
+	}
+
+	segments := strings.Split(path, "/")
+	next := 0
+	for i, seg := range segments {
+		if seg == "" {
+			continue
+		}
+		if seg[0] != ':' && seg[0] != '*' {
+			continue
+		}
+
+		if next >= len(params) {
+			return "", fmt.Errorf("router: URL(%q): missing a value for %q", name, seg)
+		}
+		segments[i] = params[next]
+		next++
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+func (router *Router) recordRoute(method, path string, fn interface{}, opts []Option) {
+	var reg registration
+	for _, opt := range opts {
+		opt(&reg)
+	}
+
+	displayFn := fn
+	if reg.displayFn != nil {
+		displayFn = reg.displayFn
+	}
+
+	router.routes = append(router.routes, RouteInfo{
+		Method:  method,
+		Path:    path,
+		Handler: funcName(displayFn),
+	})
+
+	if reg.name != "" {
+		if router.named == nil {
+			router.named = make(map[string]string)
+		}
+		router.named[reg.name] = path
+	}
+}
+
+func funcName(fn interface{}) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Sprintf("%T", fn)
+	}
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+	return "unknown"
+}
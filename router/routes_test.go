@@ -0,0 +1,75 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/teepark/contextual"
+	"golang.org/x/net/context"
+)
+
+func showUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {}
+
+func TestRoutesListsRegisteredRoutes(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/users", tagApp("users"))
+	r.POST("/users", tagApp("create"))
+
+	routes := r.Routes()
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+
+	if routes[0].Method != "GET" || routes[0].Path != "/users" {
+		t.Fatalf("unexpected route[0]: %+v", routes[0])
+	}
+	if routes[1].Method != "POST" || routes[1].Path != "/users" {
+		t.Fatalf("unexpected route[1]: %+v", routes[1])
+	}
+}
+
+func TestURLBuildsPathFromName(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/users/:id", tagApp("show"), Name("user.show"))
+
+	url, err := r.URL("user.show", "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "/users/42" {
+		t.Fatal("mismatch", url)
+	}
+}
+
+func TestURLErrorsOnUnknownName(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+
+	if _, err := r.URL("nope"); err == nil {
+		t.Fatal("expected error for unregistered name")
+	}
+}
+
+func TestRoutesReportsGroupHandlerNameThroughMiddleware(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	g := r.Group("/api", tagMiddleware("mw\n"))
+	g.GET("/users/:id", contextual.HandlerFunc(showUser))
+
+	routes := r.Routes()
+	if len(routes) != 1 {
+		t.Fatalf("expected 1 route, got %d", len(routes))
+	}
+
+	if !strings.HasSuffix(routes[0].Handler, "showUser") {
+		t.Fatalf("expected handler name to end with showUser, got %q", routes[0].Handler)
+	}
+}
+
+func TestURLErrorsOnMissingParam(t *testing.T) {
+	r := New(nil, nil, nil, nil, nil)
+	r.GET("/users/:id", tagApp("show"), Name("user.show"))
+
+	if _, err := r.URL("user.show"); err == nil {
+		t.Fatal("expected error for missing param")
+	}
+}